@@ -0,0 +1,351 @@
+package multistore
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Snapshotter is implemented by a MultiStore that can export and import a
+// self-describing state-sync snapshot of itself. It is kept separate from
+// MultiStore so that callers (e.g. ABCI state-sync) type-assert for it rather
+// than every MultiStore implementation being forced to support it.
+type Snapshotter interface {
+	// Snapshot streams every SS key/value pair at version, interleaved with
+	// the SC root hash needed to verify each store without recomputation.
+	Snapshot(version uint64, w io.Writer) error
+	// Restore reads a stream produced by Snapshot, rebuilding ss and each
+	// sc[storeKey] incrementally, and returns the version it restored.
+	Restore(r io.Reader) (uint64, error)
+	// SnapshotChunk is the chunked variant of Snapshot, splitting the stream
+	// into chunkSize-byte pieces for transport over ABCI's chunked
+	// state-sync protocol.
+	SnapshotChunk(version uint64, chunkSize int) (<-chan SnapshotChunk, <-chan error)
+	// RestoreChunk is the chunked variant of Restore, reassembling chunks
+	// delivered out of a state-sync chunk fetcher.
+	RestoreChunk(chunks <-chan SnapshotChunk) (uint64, error)
+}
+
+// SnapshotChunk is a single piece of a chunked snapshot stream.
+type SnapshotChunk struct {
+	Index uint32
+	Data  []byte
+}
+
+// snapshotMagic tags the start of a stream produced by Snapshot, guarding
+// Restore against being fed an unrelated byte stream.
+const snapshotMagic = "CSMS" // Cosmos SDK MultiStore Snapshot
+
+// maxSnapshotEntryLen bounds any single length-prefixed field read from a
+// snapshot stream (store key, key, value, or root hash). Snapshots are
+// restored from untrusted peers during state-sync, so a corrupt or hostile
+// chunk must not be able to force an arbitrarily large allocation before any
+// of the stream's content has been validated.
+const maxSnapshotEntryLen = 64 << 20 // 64 MiB
+
+// Snapshot writes a self-describing stream of every storeKey's SS key/value
+// pairs at version, each followed by the SC root hash needed to verify the
+// store without recomputing it from scratch on restore.
+func (s *Store) Snapshot(version uint64, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	if _, err := bw.WriteString(snapshotMagic); err != nil {
+		return err
+	}
+	if err := writeUvarint(bw, version); err != nil {
+		return err
+	}
+	if err := writeUvarint(bw, uint64(len(s.sc))); err != nil {
+		return err
+	}
+
+	storeKeys := make([]string, 0, len(s.sc))
+	for storeKey := range s.sc {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	for _, storeKey := range storeKeys {
+		sc, ok := s.sc[storeKey]
+		if !ok {
+			return fmt.Errorf("no commitment store found for store key %s", storeKey)
+		}
+
+		if err := writeBytes(bw, []byte(storeKey)); err != nil {
+			return err
+		}
+
+		iter, err := s.ss.Iterator(storeKey, version, nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create iterator for store key %s: %w", storeKey, err)
+		}
+
+		var count uint64
+		for ; iter.Valid(); iter.Next() {
+			count++
+		}
+		iter.Close()
+
+		if err := writeUvarint(bw, count); err != nil {
+			return err
+		}
+
+		iter, err = s.ss.Iterator(storeKey, version, nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to re-create iterator for store key %s: %w", storeKey, err)
+		}
+		for ; iter.Valid(); iter.Next() {
+			if err := writeBytes(bw, iter.Key()); err != nil {
+				iter.Close()
+				return err
+			}
+			if err := writeBytes(bw, iter.Value()); err != nil {
+				iter.Close()
+				return err
+			}
+		}
+		if err := iter.Error(); err != nil {
+			iter.Close()
+			return fmt.Errorf("iterator error while snapshotting store key %s: %w", storeKey, err)
+		}
+		iter.Close()
+
+		root, err := sc.GetRootHash(version)
+		if err != nil {
+			return fmt.Errorf("failed to get root hash for store key %s: %w", storeKey, err)
+		}
+		if err := writeBytes(bw, root); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Restore reads a stream produced by Snapshot, streaming key/value pairs
+// directly into ss and rebuilding each sc[storeKey] incrementally. The final
+// root of every commitment tree is verified against the manifest header
+// before the version is marked committed.
+//
+// If any store key fails to restore, Restore rolls back every commitment
+// tree it already committed during this call (by reloading it to the
+// version preceding the restore), and purges every key it already wrote into
+// ss at version (including from the store that was still in progress when
+// the failure happened), before returning the error. Without the ss purge,
+// a failed restore would leave ss holding data at version with no
+// committed sc root to match it.
+func (s *Store) Restore(r io.Reader) (uint64, error) {
+	br := bufio.NewReader(r)
+
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return 0, fmt.Errorf("failed to read snapshot magic: %w", err)
+	}
+	if string(magic) != snapshotMagic {
+		return 0, fmt.Errorf("not a multistore snapshot stream")
+	}
+
+	version, err := binary.ReadUvarint(br)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read snapshot version: %w", err)
+	}
+
+	numStores, err := binary.ReadUvarint(br)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read store count: %w", err)
+	}
+
+	// writtenKeys tracks every key written into ss so far, per store key,
+	// including those from the store currently being restored when it fails
+	// partway through. rollback uses it to purge ss of everything written at
+	// version, not just to reload sc back to version-1, so a failed restore
+	// never leaves ss and sc disagreeing about what version-1 state looks like.
+	writtenKeys := make(map[string][][]byte)
+	var committed []string
+	rollback := func() {
+		for storeKey, keys := range writtenKeys {
+			for _, key := range keys {
+				_ = s.ss.Delete(storeKey, version, key)
+			}
+		}
+		for _, storeKey := range committed {
+			if sc, ok := s.sc[storeKey]; ok && version > 0 {
+				_ = sc.LoadVersion(version - 1)
+			}
+		}
+	}
+
+	for i := uint64(0); i < numStores; i++ {
+		storeKeyBytes, err := readBytes(br)
+		if err != nil {
+			rollback()
+			return 0, fmt.Errorf("failed to read store key: %w", err)
+		}
+		storeKey := string(storeKeyBytes)
+
+		sc, ok := s.sc[storeKey]
+		if !ok {
+			rollback()
+			return 0, fmt.Errorf("no commitment store registered for store key %s", storeKey)
+		}
+
+		count, err := binary.ReadUvarint(br)
+		if err != nil {
+			rollback()
+			return 0, fmt.Errorf("failed to read entry count for store key %s: %w", storeKey, err)
+		}
+
+		for j := uint64(0); j < count; j++ {
+			key, err := readBytes(br)
+			if err != nil {
+				rollback()
+				return 0, fmt.Errorf("failed to read key for store key %s: %w", storeKey, err)
+			}
+			value, err := readBytes(br)
+			if err != nil {
+				rollback()
+				return 0, fmt.Errorf("failed to read value for store key %s: %w", storeKey, err)
+			}
+
+			if err := s.ss.Set(storeKey, version, key, value); err != nil {
+				rollback()
+				return 0, fmt.Errorf("failed to restore key into ss for store key %s: %w", storeKey, err)
+			}
+			writtenKeys[storeKey] = append(writtenKeys[storeKey], key)
+
+			if err := sc.Set(key, value); err != nil {
+				rollback()
+				return 0, fmt.Errorf("failed to restore key into sc for store key %s: %w", storeKey, err)
+			}
+		}
+
+		wantRoot, err := readBytes(br)
+		if err != nil {
+			rollback()
+			return 0, fmt.Errorf("failed to read manifest root hash for store key %s: %w", storeKey, err)
+		}
+
+		gotRoot, err := sc.Commit()
+		if err != nil {
+			rollback()
+			return 0, fmt.Errorf("failed to commit restored commitment tree for store key %s: %w", storeKey, err)
+		}
+		committed = append(committed, storeKey)
+
+		if string(gotRoot) != string(wantRoot) {
+			rollback()
+			return 0, fmt.Errorf("root hash mismatch for store key %s after restore: want %x, got %x", storeKey, wantRoot, gotRoot)
+		}
+	}
+
+	if err := s.LoadVersion(version); err != nil {
+		rollback()
+		return 0, fmt.Errorf("failed to load restored version %d: %w", version, err)
+	}
+
+	return version, nil
+}
+
+// SnapshotChunk is the chunked variant of Snapshot: it streams Snapshot's
+// output through an in-memory pipe and slices it into chunkSize-byte pieces,
+// suitable for ABCI's chunked state-sync transport.
+func (s *Store) SnapshotChunk(version uint64, chunkSize int) (<-chan SnapshotChunk, <-chan error) {
+	chunks := make(chan SnapshotChunk)
+	errs := make(chan error, 1)
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		defer pw.Close()
+		if err := s.Snapshot(version, pw); err != nil {
+			pw.CloseWithError(err)
+		}
+	}()
+
+	go func() {
+		defer close(chunks)
+		defer close(errs)
+
+		buf := make([]byte, chunkSize)
+		var index uint32
+		for {
+			n, err := io.ReadFull(pr, buf)
+			if n > 0 {
+				data := make([]byte, n)
+				copy(data, buf[:n])
+				chunks <- SnapshotChunk{Index: index, Data: data}
+				index++
+			}
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return
+			}
+			if err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	return chunks, errs
+}
+
+// RestoreChunk is the chunked variant of Restore: it reassembles chunks
+// (which must arrive in Index order) into a single stream and delegates to
+// Restore.
+func (s *Store) RestoreChunk(chunks <-chan SnapshotChunk) (uint64, error) {
+	pr, pw := io.Pipe()
+	// If Restore returns early (error or otherwise) while the goroutine below
+	// is still draining chunks, closing pr unblocks any in-flight or future
+	// pw.Write with io.ErrClosedPipe so the goroutine doesn't leak forever.
+	defer pr.Close()
+
+	go func() {
+		var nextIndex uint32
+		for chunk := range chunks {
+			if chunk.Index != nextIndex {
+				pw.CloseWithError(fmt.Errorf("out of order snapshot chunk: want index %d, got %d", nextIndex, chunk.Index))
+				return
+			}
+			if _, err := pw.Write(chunk.Data); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			nextIndex++
+		}
+		pw.Close()
+	}()
+
+	return s.Restore(pr)
+}
+
+func writeUvarint(w io.Writer, v uint64) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	_, err := w.Write(buf[:n])
+	return err
+}
+
+func writeBytes(w io.Writer, b []byte) error {
+	if err := writeUvarint(w, uint64(len(b))); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxSnapshotEntryLen {
+		return nil, fmt.Errorf("snapshot entry length %d exceeds maximum of %d", length, maxSnapshotEntryLen)
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
@@ -0,0 +1,42 @@
+package multistore
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+)
+
+func TestWriteReadBytesRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+
+	want := [][]byte{nil, []byte(""), []byte("hello"), bytes.Repeat([]byte{0xAB}, 1024)}
+	for _, b := range want {
+		if err := writeBytes(&buf, b); err != nil {
+			t.Fatalf("writeBytes: %v", err)
+		}
+	}
+
+	br := bufio.NewReader(&buf)
+	for i, b := range want {
+		got, err := readBytes(br)
+		if err != nil {
+			t.Fatalf("readBytes entry %d: %v", i, err)
+		}
+		if !bytes.Equal(got, b) {
+			t.Fatalf("entry %d: want %x, got %x", i, b, got)
+		}
+	}
+}
+
+func TestReadBytesRejectsOversizedEntry(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := writeUvarint(&buf, maxSnapshotEntryLen+1); err != nil {
+		t.Fatalf("writeUvarint: %v", err)
+	}
+
+	br := bufio.NewReader(&buf)
+	if _, err := readBytes(br); err == nil {
+		t.Fatalf("expected readBytes to reject an entry length over maxSnapshotEntryLen")
+	}
+}
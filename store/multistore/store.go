@@ -1,6 +1,8 @@
 package multistore
 
 import (
+	"fmt"
+
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
 	ics23 "github.com/cosmos/ics23/go"
@@ -9,9 +11,21 @@ import (
 // MultiStore defines an abstraction layer containing a State Storage (SS) engine
 // and one or more State Commitment (SC) engines.
 //
+// Implementations that can export and import state-sync snapshots should
+// additionally implement Snapshotter; callers type-assert for it rather than
+// requiring every MultiStore to support snapshotting.
+//
 // TODO: Move this type to the Core package.
 type MultiStore interface {
 	GetProof(storeKey string, version uint64, key []byte) (*ics23.CommitmentProof, error)
+	// GetRangeProof returns a batch proof covering every key in [start, end),
+	// up to limit entries, along with the keys and values it covers.
+	GetRangeProof(storeKey string, version uint64, start, end []byte, limit uint32) (*ics23.BatchProof, [][]byte, [][]byte, error)
+	// GetNonExistenceProof returns an ICS23 non-existence proof for key,
+	// consisting of existence proofs for its left and right neighbours in the
+	// committed key space (a missing neighbour means key is outside the
+	// range covered by the tree on that side).
+	GetNonExistenceProof(storeKey string, version uint64, key []byte) (*ics23.NonExistenceProof, error)
 	LoadVersion(version uint64) error
 	WorkingHash() []byte
 	Commit() ([]byte, error)
@@ -20,4 +34,101 @@ type MultiStore interface {
 type Store struct {
 	ss store.VersionedDatabase
 	sc map[string]*commitment.Database
-}
\ No newline at end of file
+}
+
+// GetRangeProof returns a batch proof covering every key in [start, end), up
+// to limit entries, by iterating the SS backend for the storeKey and asking
+// the corresponding SC commitment tree for a membership proof per key.
+func (s *Store) GetRangeProof(storeKey string, version uint64, start, end []byte, limit uint32) (*ics23.BatchProof, [][]byte, [][]byte, error) {
+	sc, ok := s.sc[storeKey]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("no commitment store found for store key %s", storeKey)
+	}
+
+	iter, err := s.ss.Iterator(storeKey, version, start, end)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create iterator for store key %s: %w", storeKey, err)
+	}
+	defer iter.Close()
+
+	var (
+		retKeys   [][]byte
+		retValues [][]byte
+		entries   []*ics23.ExistenceProof
+	)
+
+	for ; iter.Valid() && uint32(len(entries)) < limit; iter.Next() {
+		key, value := iter.Key(), iter.Value()
+
+		proof, err := sc.GetProof(version, key)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to generate membership proof for key %x: %w", key, err)
+		}
+
+		existence := proof.GetExist()
+		if existence == nil {
+			return nil, nil, nil, fmt.Errorf("expected existence proof for key %x within range", key)
+		}
+
+		entries = append(entries, existence)
+		retKeys = append(retKeys, key)
+		retValues = append(retValues, value)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, nil, nil, fmt.Errorf("iterator error while building range proof: %w", err)
+	}
+
+	return &ics23.BatchProof{Entries: entries}, retKeys, retValues, nil
+}
+
+// GetNonExistenceProof returns an ICS23 non-existence proof for key, built
+// from existence proofs of its immediate left and right neighbours in the SS
+// backend. A nil neighbour on either side means key falls outside the range
+// committed by the tree on that side.
+func (s *Store) GetNonExistenceProof(storeKey string, version uint64, key []byte) (*ics23.NonExistenceProof, error) {
+	sc, ok := s.sc[storeKey]
+	if !ok {
+		return nil, fmt.Errorf("no commitment store found for store key %s", storeKey)
+	}
+
+	leftIter, err := s.ss.ReverseIterator(storeKey, version, nil, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create left iterator for store key %s: %w", storeKey, err)
+	}
+	defer leftIter.Close()
+
+	var left *ics23.ExistenceProof
+	if leftIter.Valid() {
+		proof, err := sc.GetProof(version, leftIter.Key())
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate left neighbour proof: %w", err)
+		}
+		left = proof.GetExist()
+	}
+
+	rightIter, err := s.ss.Iterator(storeKey, version, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create right iterator for store key %s: %w", storeKey, err)
+	}
+	defer rightIter.Close()
+
+	var right *ics23.ExistenceProof
+	if rightIter.Valid() {
+		if string(rightIter.Key()) == string(key) {
+			return nil, fmt.Errorf("key %x exists, cannot generate non-existence proof", key)
+		}
+
+		proof, err := sc.GetProof(version, rightIter.Key())
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate right neighbour proof: %w", err)
+		}
+		right = proof.GetExist()
+	}
+
+	return &ics23.NonExistenceProof{
+		Key:   key,
+		Left:  left,
+		Right: right,
+	}, nil
+}
@@ -10,9 +10,11 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/accounts/accountstd"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
 	accountsv1 "cosmossdk.io/x/accounts/v1"
 	"cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -25,10 +27,77 @@ type Account struct {
 	PubKey   collections.Item[secp256k1.PubKey]
 	Sequence collections.Sequence
 
+	// SessionKeys holds the short-lived sub-authenticators registered against
+	// this account, keyed by the raw bytes of the session's public key.
+	SessionKeys collections.Map[[]byte, basev1.SessionKey]
+
+	// EncryptionKey is the account's X25519 (or similar) encryption public
+	// key, set independently from PubKey via MsgSetEncryptionKey. It is
+	// unset (absent) until the account opts in.
+	EncryptionKey collections.Item[[]byte]
+
 	addrCodec address.Codec
 	hs        header.Service
 
 	signingHandlers signing.HandlerMap
+
+	// subscriptions lets a x/subscription funder cover this account's tx fees
+	// in place of the normal fee payer. It is nil unless the account was
+	// constructed via NewAccountWithSubscriptions; while it is nil, paying via
+	// a subscription is simply skipped.
+	subscriptions SubscriptionKeeper
+
+	// authSessionKey records, for the duration of the current tx, the raw
+	// public key bytes of the session key that authenticated it, if any
+	// (absent when the tx was authenticated with the primary key). It is set
+	// by Authenticate before the tx's messages are dispatched and consulted
+	// by RegisterSessionKey, so a session key cannot mint another one for
+	// itself.
+	authSessionKey collections.Item[[]byte]
+}
+
+// NewAccount constructs a base Account, registering PubKey, Sequence,
+// SessionKeys and EncryptionKey against deps.SchemaBuilder. This is the only
+// place these collections are initialized; an Account created any other way
+// panics the first time one of its collections is touched.
+func NewAccount(deps accountstd.Dependencies) (Account, error) {
+	return Account{
+		PubKey: collections.NewItem(
+			deps.SchemaBuilder, collections.NewPrefix(0), "pub_key",
+			codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec),
+		),
+		Sequence: collections.NewSequence(deps.SchemaBuilder, collections.NewPrefix(1), "sequence"),
+		SessionKeys: collections.NewMap(
+			deps.SchemaBuilder, collections.NewPrefix(2), "session_keys",
+			collections.BytesKey, codec.CollValue[basev1.SessionKey](deps.LegacyStateCodec),
+		),
+		EncryptionKey: collections.NewItem(
+			deps.SchemaBuilder, collections.NewPrefix(3), "encryption_key",
+			collections.BytesValue,
+		),
+		authSessionKey: collections.NewItem(
+			deps.SchemaBuilder, collections.NewPrefix(4), "auth_session_key",
+			collections.BytesValue,
+		),
+		addrCodec:       deps.AddressCodec,
+		hs:              deps.HeaderService,
+		signingHandlers: deps.SigningHandlers,
+	}, nil
+}
+
+// NewAccountWithSubscriptions returns an account constructor identical to
+// NewAccount, except Authenticate will additionally let a x/subscription
+// funder cover this account's tx fees. Apps that wire up x/subscription
+// should register this instead of NewAccount.
+func NewAccountWithSubscriptions(subscriptions SubscriptionKeeper) func(accountstd.Dependencies) (Account, error) {
+	return func(deps accountstd.Dependencies) (Account, error) {
+		acc, err := NewAccount(deps)
+		if err != nil {
+			return Account{}, err
+		}
+		acc.subscriptions = subscriptions
+		return acc, nil
+	}
 }
 
 // Authenticate implements the authentication flow of an abstracted account.
@@ -37,28 +106,43 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, fmt.Errorf("unauthorized: only accounts module is allowed to call this")
 	}
 
-	pubKey, signerData, err := a.computeSignerData(ctx)
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+
+	sessionKey, sessionKeyBytes, err := a.findActiveSessionKey(ctx, signerInfo.PublicKey)
 	if err != nil {
 		return nil, err
 	}
 
-	txData, err := a.getTxData(msg)
+	var (
+		pubKey     secp256k1.PubKey
+		signerData signing.SignerData
+	)
+	if sessionKey != nil {
+		pubKey, signerData, err = a.computeSessionSignerData(ctx, sessionKey, signerInfo)
+	} else {
+		pubKey, signerData, err = a.computeSignerData(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	gotSeq := signerInfo.Sequence
 	if gotSeq != signerData.Sequence {
 		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
 	}
 
-	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	signMode, err := parseSignMode(signerInfo.ModeInfo)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
 	}
 
 	signature := msg.Tx.Signatures[msg.SignerIndex]
 
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
 	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
 	if err != nil {
 		return nil, err
@@ -68,6 +152,20 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, fmt.Errorf("signature verification failed")
 	}
 
+	if sessionKey != nil {
+		if err := a.authorizeSessionMsgs(ctx, sessionKeyBytes, sessionKey, txData.Body.Messages); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.setAuthSessionKey(ctx, sessionKeyBytes); err != nil {
+		return nil, err
+	}
+
+	if err := a.paySubscriptionFee(ctx, msg, txData); err != nil {
+		return nil, err
+	}
+
 	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
 }
 
@@ -0,0 +1,45 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+)
+
+// SetEncryptionKey sets (or replaces) the account's encryption key. Only the
+// account itself can set its own encryption key, which it does by signing a
+// MsgSetEncryptionKey like any other message going through Authenticate.
+func (a Account) SetEncryptionKey(ctx context.Context, msg *basev1.MsgSetEncryptionKey) (*basev1.MsgSetEncryptionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, fmt.Errorf("unauthorized: only the account itself can set its encryption key")
+	}
+
+	if err := a.EncryptionKey.Set(ctx, msg.EncryptionKey); err != nil {
+		return nil, err
+	}
+
+	return &basev1.MsgSetEncryptionKeyResponse{}, nil
+}
+
+// QueryEncryptionKey returns the account's encryption key, or an empty key if
+// the account never set one.
+//
+// Batch lookups across many addresses are served by
+// x/accounts/keeper.QueryServer.EncryptionKeys, which fans out to this query
+// for each requested address and omits the ones that come back empty or
+// unknown.
+func (a Account) QueryEncryptionKey(ctx context.Context, _ *basev1.QueryEncryptionKeyRequest) (*basev1.QueryEncryptionKeyResponse, error) {
+	encKey, err := a.EncryptionKey.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return &basev1.QueryEncryptionKeyResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &basev1.QueryEncryptionKeyResponse{EncryptionKey: encKey}, nil
+}
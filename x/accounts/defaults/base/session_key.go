@@ -0,0 +1,259 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	"cosmossdk.io/x/tx/signing"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// RegisterSessionKey registers a new short-lived sub-authenticator on the
+// account. Only the account itself can register a session key for itself,
+// which it does by signing a MsgRegisterSessionKey with its primary key. A
+// tx authenticated by a session key cannot register another session key.
+func (a Account) RegisterSessionKey(ctx context.Context, msg *basev1.MsgRegisterSessionKey) (*basev1.MsgRegisterSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, fmt.Errorf("unauthorized: only the account itself can register a session key")
+	}
+
+	authSK, err := a.authSessionKey.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if len(authSK) > 0 {
+		return nil, fmt.Errorf("unauthorized: a session key cannot register another session key")
+	}
+
+	pubKeyBytes, _, err := unpackSecp256k1Any(msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := a.SessionKeys.Get(ctx, pubKeyBytes); err == nil {
+		return nil, fmt.Errorf("session key already registered")
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	sk := basev1.SessionKey{
+		PubKey:       msg.PubKey,
+		ExpiryHeight: msg.ExpiryHeight,
+		AllowedMsgs:  msg.AllowedMsgs,
+		SpendLimit:   msg.SpendLimit,
+	}
+	if err := a.SessionKeys.Set(ctx, pubKeyBytes, sk); err != nil {
+		return nil, err
+	}
+
+	return &basev1.MsgRegisterSessionKeyResponse{}, nil
+}
+
+// RevokeSessionKey removes a previously registered session key, identified by
+// the raw bytes of its public key.
+func (a Account) RevokeSessionKey(ctx context.Context, msg *basev1.MsgRevokeSessionKey) (*basev1.MsgRevokeSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, fmt.Errorf("unauthorized: only the account itself can revoke a session key")
+	}
+
+	if err := a.SessionKeys.Remove(ctx, msg.PubKeyBytes); err != nil {
+		return nil, err
+	}
+
+	return &basev1.MsgRevokeSessionKeyResponse{}, nil
+}
+
+// QuerySessionKeys lists the active, unexpired session keys registered on
+// the account.
+func (a Account) QuerySessionKeys(ctx context.Context, _ *basev1.QuerySessionKeysRequest) (*basev1.QuerySessionKeysResponse, error) {
+	height := a.hs.GetHeaderInfo(ctx).Height
+
+	var sessionKeys []*basev1.SessionKey
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sk basev1.SessionKey) (stop bool, err error) {
+		if sk.ExpiryHeight != 0 && height >= sk.ExpiryHeight {
+			return false, nil
+		}
+		sk := sk
+		sessionKeys = append(sessionKeys, &sk)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &basev1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+// findActiveSessionKey returns the session key matching the given signer
+// public key, if any. A nil result (with a nil error) means the signer should
+// fall back to the account's primary key.
+func (a Account) findActiveSessionKey(ctx context.Context, pubKeyAny *codectypes.Any) (*basev1.SessionKey, []byte, error) {
+	if pubKeyAny == nil {
+		return nil, nil, nil
+	}
+
+	pubKeyBytes, _, err := unpackSecp256k1Any(pubKeyAny)
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	sk, err := a.SessionKeys.Get(ctx, pubKeyBytes)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	height := a.hs.GetHeaderInfo(ctx).Height
+	if sk.ExpiryHeight != 0 && height >= sk.ExpiryHeight {
+		return nil, nil, fmt.Errorf("session key expired at height %d", sk.ExpiryHeight)
+	}
+
+	return &sk, pubKeyBytes, nil
+}
+
+// setAuthSessionKey records which key authenticated the current tx, so
+// RegisterSessionKey can tell a session key apart from the primary key.
+// sessionKeyBytes is nil when the tx was authenticated with the primary key.
+func (a Account) setAuthSessionKey(ctx context.Context, sessionKeyBytes []byte) error {
+	return a.authSessionKey.Set(ctx, sessionKeyBytes)
+}
+
+// computeSessionSignerData mirrors computeSignerData but authenticates against
+// a session key, tracking its own sub-sequence so that parallel sessions don't
+// collide with each other or with the account's main sequence.
+func (a Account) computeSessionSignerData(ctx context.Context, sk *basev1.SessionKey, signerInfo *tx.SignerInfo) (secp256k1.PubKey, signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+	chainID := a.hs.GetHeaderInfo(ctx).ChainID
+
+	pubKeyBytes, pk, err := unpackSecp256k1Any(signerInfo.PublicKey)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+
+	accNum, err := a.getNumber(ctx, addrStr)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+
+	wantSequence := sk.SubSequence
+	sk.SubSequence++
+	if err := a.SessionKeys.Set(ctx, pubKeyBytes, *sk); err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+
+	return pk, signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      wantSequence,
+		PubKey: &anypb.Any{
+			TypeUrl: pkAny.TypeUrl,
+			Value:   pkAny.Value,
+		},
+	}, nil
+}
+
+// authorizeSessionMsgs enforces the session key's message allow-list and
+// spend cap against the messages of the tx being authenticated, persisting
+// the decremented spend cap back to state.
+func (a Account) authorizeSessionMsgs(ctx context.Context, pubKeyBytes []byte, sk *basev1.SessionKey, msgs []*anypb.Any) error {
+	if len(sk.AllowedMsgs) > 0 {
+		allowed := make(map[string]bool, len(sk.AllowedMsgs))
+		for _, typeURL := range sk.AllowedMsgs {
+			allowed[typeURL] = true
+		}
+		for _, msg := range msgs {
+			if !allowed[msg.TypeUrl] {
+				return fmt.Errorf("session key is not allowed to execute %s", msg.TypeUrl)
+			}
+		}
+	}
+
+	if len(sk.SpendLimit) == 0 {
+		return nil
+	}
+
+	spent := make(map[string]math.Int)
+	for _, msg := range msgs {
+		if msg.TypeUrl != "/cosmos.bank.v1beta1.MsgSend" {
+			continue
+		}
+		send := new(banktypes.MsgSend)
+		if err := gogoproto.Unmarshal(msg.Value, send); err != nil {
+			return fmt.Errorf("unable to decode send message for spend cap check: %w", err)
+		}
+		for _, coin := range send.Amount {
+			cur, ok := spent[coin.Denom]
+			if !ok {
+				cur = math.ZeroInt()
+			}
+			spent[coin.Denom] = cur.Add(coin.Amount)
+		}
+	}
+	if len(spent) == 0 {
+		return nil
+	}
+
+	limits := make(map[string]math.Int, len(sk.SpendLimit))
+	for _, c := range sk.SpendLimit {
+		limit, ok := math.NewIntFromString(c.Amount)
+		if !ok {
+			return fmt.Errorf("invalid spend limit amount %q for denom %s", c.Amount, c.Denom)
+		}
+		limits[c.Denom] = limit
+	}
+
+	for denom, amt := range spent {
+		remaining, ok := limits[denom]
+		if !ok || amt.GT(remaining) {
+			return fmt.Errorf("session key spend cap exceeded for denom %s", denom)
+		}
+		limits[denom] = remaining.Sub(amt)
+	}
+
+	newLimit := make([]*basev1.Coin, 0, len(sk.SpendLimit))
+	for _, c := range sk.SpendLimit {
+		newLimit = append(newLimit, &basev1.Coin{Denom: c.Denom, Amount: limits[c.Denom].String()})
+	}
+	sk.SpendLimit = newLimit
+
+	return a.SessionKeys.Set(ctx, pubKeyBytes, *sk)
+}
+
+// unpackSecp256k1Any unmarshals the secp256k1 public key carried by a
+// cosmos-sdk codec Any, returning both its raw key bytes (used as the
+// SessionKeys map key) and the decoded pubkey.
+func unpackSecp256k1Any(any *codectypes.Any) ([]byte, secp256k1.PubKey, error) {
+	if any == nil {
+		return nil, secp256k1.PubKey{}, fmt.Errorf("nil public key")
+	}
+	var pk secp256k1.PubKey
+	if err := gogoproto.Unmarshal(any.Value, &pk); err != nil {
+		return nil, secp256k1.PubKey{}, err
+	}
+	if len(pk.Key) == 0 {
+		return nil, secp256k1.PubKey{}, fmt.Errorf("empty public key")
+	}
+	return bytes.Clone(pk.Key), pk, nil
+}
@@ -0,0 +1,73 @@
+package base
+
+import (
+	"context"
+	"testing"
+
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func mustPubKeyAny(t *testing.T) (*codectypes.Any, []byte) {
+	t.Helper()
+
+	priv := secp256k1.GenPrivKey()
+	pk := priv.PubKey().(*secp256k1.PubKey)
+
+	value, err := gogoproto.Marshal(pk)
+	if err != nil {
+		t.Fatalf("marshal pubkey: %v", err)
+	}
+
+	return &codectypes.Any{
+		TypeUrl: "/cosmos.crypto.secp256k1.PubKey",
+		Value:   value,
+	}, pk.Key
+}
+
+func TestUnpackSecp256k1Any(t *testing.T) {
+	any, keyBytes := mustPubKeyAny(t)
+
+	gotBytes, pk, err := unpackSecp256k1Any(any)
+	if err != nil {
+		t.Fatalf("unpackSecp256k1Any returned error: %v", err)
+	}
+	if string(gotBytes) != string(keyBytes) {
+		t.Fatalf("key bytes mismatch: want %x, got %x", keyBytes, gotBytes)
+	}
+	if len(pk.Key) == 0 {
+		t.Fatalf("decoded pubkey has empty key")
+	}
+
+	if _, _, err := unpackSecp256k1Any(nil); err == nil {
+		t.Fatalf("expected error for nil Any")
+	}
+
+	if _, _, err := unpackSecp256k1Any(&codectypes.Any{Value: []byte("garbage")}); err == nil {
+		t.Fatalf("expected error for malformed Any value")
+	}
+}
+
+func TestAuthorizeSessionMsgsAllowList(t *testing.T) {
+	a := Account{}
+	sk := &basev1.SessionKey{
+		AllowedMsgs: []string{"/cosmos.bank.v1beta1.MsgSend"},
+	}
+
+	msgs := []*anypb.Any{
+		{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+	}
+	if err := a.authorizeSessionMsgs(context.Background(), nil, sk, msgs); err != nil {
+		t.Fatalf("expected allowed message to pass, got: %v", err)
+	}
+
+	disallowed := []*anypb.Any{
+		{TypeUrl: "/cosmos.staking.v1beta1.MsgDelegate"},
+	}
+	if err := a.authorizeSessionMsgs(context.Background(), nil, sk, disallowed); err == nil {
+		t.Fatalf("expected disallowed message to be rejected")
+	}
+}
@@ -0,0 +1,85 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SubscriptionKeeper is the subset of x/subscription's keeper used to let a
+// subscription's funder cover this account's tx fees in place of the normal
+// fee payer.
+type SubscriptionKeeper interface {
+	ConsumeAllowance(ctx context.Context, account string, msgTypeURLs []string, gasUsed uint64, fee sdk.Coins, feeCollectorName string) (ok bool, err error)
+}
+
+// paySubscriptionFee lets a subscription's funder cover this account's tx
+// fees in place of the normal fee payer, whenever this account is the
+// subscribed account, it is the implicit fee payer (the tx names no
+// separate one), the tx's messages fall within the plan's allow-list, and
+// the plan's remaining allowance can cover the declared gas limit.
+//
+// It is a no-op, falling back to the normal fee payer, if the account has no
+// subscription, the subscription doesn't cover this tx, or the account
+// wasn't constructed with a SubscriptionKeeper at all.
+//
+// On success, it zeroes msg.Tx.AuthInfo.Fee.Amount in place. msg.Tx is the
+// same Tx the rest of the tx-processing pipeline reads the fee from once
+// Authenticate returns, so this is what stops the normal fee payer from
+// being charged a second time for a fee the subscription already collected.
+func (a Account) paySubscriptionFee(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate, txData signing.TxData) error {
+	if a.subscriptions == nil {
+		return nil
+	}
+
+	fee := txData.AuthInfo.Fee
+	if fee == nil {
+		return nil
+	}
+
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	if fee.Payer != "" && fee.Payer != addrStr {
+		// An explicit fee payer other than this account is named; that
+		// account's own Authenticate call is responsible for its
+		// subscription, not this one.
+		return nil
+	}
+	if fee.Payer == "" && msg.SignerIndex != 0 {
+		// No fee payer is named, so the first signer pays, and this
+		// account is not the first signer.
+		return nil
+	}
+
+	feeCoins := make(sdk.Coins, 0, len(fee.Amount))
+	for _, c := range fee.Amount {
+		amt, ok := math.NewIntFromString(c.Amount)
+		if !ok {
+			return fmt.Errorf("invalid fee amount %q for denom %s", c.Amount, c.Denom)
+		}
+		feeCoins = append(feeCoins, sdk.NewCoin(c.Denom, amt))
+	}
+
+	msgTypeURLs := make([]string, len(txData.Body.Messages))
+	for i, msg := range txData.Body.Messages {
+		msgTypeURLs[i] = msg.TypeUrl
+	}
+
+	ok, err := a.subscriptions.ConsumeAllowance(ctx, addrStr, msgTypeURLs, fee.GasLimit, feeCoins, authtypes.FeeCollectorName)
+	if err != nil {
+		return err
+	}
+	if ok && msg.Tx.AuthInfo.Fee != nil {
+		msg.Tx.AuthInfo.Fee.Amount = nil
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/transaction"
+)
+
+// AccountsRouter is the subset of the accounts module's core keeper this
+// query server needs to route a query into a specific account's own query
+// handlers (e.g. defaults/base's QueryEncryptionKey).
+type AccountsRouter interface {
+	Query(ctx context.Context, accountAddr []byte, queryRequest transaction.Msg) (transaction.Msg, error)
+}
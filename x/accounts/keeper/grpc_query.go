@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+)
+
+// QueryServer implements cross-account queries that don't belong to any
+// single account implementation, by fanning out to AccountsRouter for each
+// requested address.
+type QueryServer struct {
+	addrCodec address.Codec
+	accounts  AccountsRouter
+}
+
+// NewQueryServer constructs a new QueryServer.
+func NewQueryServer(addrCodec address.Codec, accounts AccountsRouter) QueryServer {
+	return QueryServer{addrCodec: addrCodec, accounts: accounts}
+}
+
+var _ basev1.QueryServer = QueryServer{}
+
+// EncryptionKeys batch-fetches the encryption keys of several accounts in a
+// single round-trip, fanning out to each account's QueryEncryptionKey and
+// omitting addresses that are malformed, don't exist, aren't a base account,
+// or never set a key, rather than erroring the whole request.
+func (s QueryServer) EncryptionKeys(ctx context.Context, req *basev1.QueryEncryptionKeysRequest) (*basev1.QueryEncryptionKeysResponse, error) {
+	keys := make(map[string][]byte, len(req.Addresses))
+	for _, addrStr := range req.Addresses {
+		addr, err := s.addrCodec.StringToBytes(addrStr)
+		if err != nil {
+			continue
+		}
+
+		respMsg, err := s.accounts.Query(ctx, addr, &basev1.QueryEncryptionKeyRequest{})
+		if err != nil {
+			continue
+		}
+
+		resp, ok := respMsg.(*basev1.QueryEncryptionKeyResponse)
+		if !ok || len(resp.EncryptionKey) == 0 {
+			continue
+		}
+
+		keys[addrStr] = resp.EncryptionKey
+	}
+
+	return &basev1.QueryEncryptionKeysResponse{EncryptionKeys: keys}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/core/transaction"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+)
+
+type fakeAddrCodec struct{}
+
+func (fakeAddrCodec) StringToBytes(addr string) ([]byte, error) {
+	if addr == "malformed" {
+		return nil, fmt.Errorf("malformed address: %s", addr)
+	}
+	return []byte(addr), nil
+}
+
+func (fakeAddrCodec) BytesToString(addr []byte) (string, error) {
+	return string(addr), nil
+}
+
+type fakeAccountsRouter struct {
+	keys map[string][]byte
+}
+
+func (r fakeAccountsRouter) Query(_ context.Context, accountAddr []byte, _ transaction.Msg) (transaction.Msg, error) {
+	key, ok := r.keys[string(accountAddr)]
+	if !ok {
+		return nil, fmt.Errorf("no account at address %s", accountAddr)
+	}
+	return &basev1.QueryEncryptionKeyResponse{EncryptionKey: key}, nil
+}
+
+func TestEncryptionKeysSkipsMalformedAndMissingAddresses(t *testing.T) {
+	router := fakeAccountsRouter{keys: map[string][]byte{
+		"good":  []byte("enc-key"),
+		"unset": nil,
+	}}
+	s := NewQueryServer(fakeAddrCodec{}, router)
+
+	resp, err := s.EncryptionKeys(context.Background(), &basev1.QueryEncryptionKeysRequest{
+		Addresses: []string{"good", "malformed", "missing", "unset"},
+	})
+	if err != nil {
+		t.Fatalf("EncryptionKeys returned error: %v", err)
+	}
+
+	if len(resp.EncryptionKeys) != 1 {
+		t.Fatalf("expected exactly one key, got %d: %v", len(resp.EncryptionKeys), resp.EncryptionKeys)
+	}
+	if string(resp.EncryptionKeys["good"]) != "enc-key" {
+		t.Fatalf("unexpected key for %q: %s", "good", resp.EncryptionKeys["good"])
+	}
+}
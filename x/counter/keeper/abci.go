@@ -0,0 +1,23 @@
+package keeper
+
+import "context"
+
+// EndBlocker publishes the counter's current value to WatchCount
+// subscribers once per block, after every message in the block has executed
+// (and any reverted message's changes have already been discarded), rather
+// than synchronously from IncreaseCount where a simulated or later-reverted
+// change would otherwise be broadcast despite never landing in committed
+// state.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	count, err := k.Count.Get(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	k.hub.publishIfChanged(count)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/counter/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GetCount queries the current value of the counter.
+func (k Keeper) GetCount(ctx context.Context, _ *types.QueryGetCountRequest) (*types.QueryGetCountResponse, error) {
+	count, err := k.Count.Get(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return &types.QueryGetCountResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QueryGetCountResponse{TotalCount: count}, nil
+}
+
+// GetCountAt queries the counter's value as it stood at a historical
+// version.
+func (k Keeper) GetCountAt(_ context.Context, req *types.QueryGetCountAtRequest) (*types.QueryGetCountResponse, error) {
+	count, err := k.countAtVersion(req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetCountResponse{TotalCount: count}, nil
+}
+
+// WatchCount streams a QueryGetCountResponse every time the counter changes,
+// until the client disconnects.
+func (k Keeper) WatchCount(_ *types.QueryWatchCountRequest, stream types.Query_WatchCountServer) error {
+	updates, unsubscribe := k.Subscribe()
+	defer unsubscribe()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case count, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&types.QueryGetCountResponse{TotalCount: count}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import "sync"
+
+// subscriberBuffer is the number of unconsumed count updates buffered per
+// WatchCount subscriber before the hub starts dropping the oldest one to
+// keep publishing non-blocking for slow clients.
+const subscriberBuffer = 16
+
+// countHub fans out post-commit count changes to every WatchCount
+// subscriber. Publishing never blocks: a subscriber that falls behind has its
+// oldest buffered update dropped to make room for the newest one.
+type countHub struct {
+	mu          sync.Mutex
+	subscribers map[chan int64]struct{}
+
+	// published and hasPublished track the last value handed to publish, so
+	// publishIfChanged can skip re-broadcasting an unchanged count every
+	// block.
+	published    int64
+	hasPublished bool
+}
+
+func newCountHub() *countHub {
+	return &countHub{
+		subscribers: make(map[chan int64]struct{}),
+	}
+}
+
+func (h *countHub) subscribe() (<-chan int64, func()) {
+	ch := make(chan int64, subscriberBuffer)
+
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subscribers[ch]; ok {
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// publishIfChanged publishes count to every subscriber, unless it is the
+// same value most recently published.
+func (h *countHub) publishIfChanged(count int64) {
+	h.mu.Lock()
+	if h.hasPublished && h.published == count {
+		h.mu.Unlock()
+		return
+	}
+	h.hasPublished = true
+	h.published = count
+	h.mu.Unlock()
+
+	h.publish(count)
+}
+
+func (h *countHub) publish(count int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers {
+		select {
+		case ch <- count:
+		default:
+			// Slow subscriber: drop the oldest buffered update and retry
+			// once so the latest value always gets through.
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- count:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import "testing"
+
+func TestPublishIfChangedSkipsDuplicates(t *testing.T) {
+	h := newCountHub()
+	ch, unsubscribe := h.subscribe()
+	defer unsubscribe()
+
+	h.publishIfChanged(1)
+	h.publishIfChanged(1)
+	h.publishIfChanged(2)
+
+	var got []int64
+	for i := 0; i < 2; i++ {
+		select {
+		case v := <-ch:
+			got = append(got, v)
+		default:
+			t.Fatalf("expected a buffered update at index %d, got none", i)
+		}
+	}
+
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("expected [1 2] (duplicate 1 skipped), got %v", got)
+	}
+
+	select {
+	case v := <-ch:
+		t.Fatalf("expected no further updates, got %d", v)
+	default:
+	}
+}
+
+func TestPublishDropsOldestWhenSubscriberFull(t *testing.T) {
+	h := newCountHub()
+	ch, unsubscribe := h.subscribe()
+	defer unsubscribe()
+
+	for i := int64(0); i < subscriberBuffer+1; i++ {
+		h.publish(i)
+	}
+
+	var got []int64
+	for {
+		select {
+		case v := <-ch:
+			got = append(got, v)
+		default:
+			goto done
+		}
+	}
+done:
+	if len(got) != subscriberBuffer {
+		t.Fatalf("expected %d buffered updates, got %d", subscriberBuffer, len(got))
+	}
+	if got[0] != 1 {
+		t.Fatalf("expected oldest update (0) to have been dropped, got oldest remaining = %d", got[0])
+	}
+	if got[len(got)-1] != subscriberBuffer {
+		t.Fatalf("expected newest update (%d) to survive, got %d", subscriberBuffer, got[len(got)-1])
+	}
+}
+
+func TestUnsubscribeClosesChannel(t *testing.T) {
+	h := newCountHub()
+	ch, unsubscribe := h.subscribe()
+
+	unsubscribe()
+
+	if _, ok := <-ch; ok {
+		t.Fatalf("expected channel to be closed after unsubscribe")
+	}
+
+	// Publishing after every subscriber has unsubscribed must not panic.
+	h.publish(1)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// storeKey is the versioned-database store key the counter module's state
+// lives under, used for historical reads via GetCountAt.
+const storeKey = "counter"
+
+// countItemPrefix is the prefix Count's collections.Item is registered
+// under. A collections.Item has no key component of its own, so the prefix
+// bytes are the entire on-disk key it stores Count at; countKey below must
+// be derived from this same value, not a hand-guessed literal, or
+// countAtVersion silently reads a key nothing was ever written to.
+var countItemPrefix = collections.NewPrefix(0)
+
+// countKey is the raw key the counter's current value is stored at within
+// storeKey, mirroring the key collections.Item[int64] uses internally.
+var countKey = []byte(countItemPrefix)
+
+// Keeper manages the counter module's state and fans out count changes to
+// WatchCount subscribers.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService corestore.KVStoreService
+
+	// versionedStore backs GetCountAt's historical reads; it is the same SS
+	// engine the chain's multistore reads through, scoped to storeKey.
+	versionedStore store.VersionedDatabase
+
+	Count collections.Item[int64]
+
+	hub *countHub
+}
+
+// NewKeeper constructs a new counter Keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, versionedStore store.VersionedDatabase) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	return Keeper{
+		cdc:            cdc,
+		storeService:   storeService,
+		versionedStore: versionedStore,
+		Count:          collections.NewItem(sb, countItemPrefix, "count", collections.Int64Value),
+		hub:            newCountHub(),
+	}
+}
+
+// IncreaseCount increments the counter by one and persists the new value.
+// WatchCount subscribers are notified later, from EndBlocker, once the value
+// is known to have actually landed in committed state.
+func (k Keeper) IncreaseCount(ctx context.Context) (int64, error) {
+	count, err := k.Count.Get(ctx)
+	if err != nil && !isNotFound(err) {
+		return 0, err
+	}
+
+	count++
+	if err := k.Count.Set(ctx, count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// countAtVersion returns the counter's value as it stood at version, reading
+// directly from the SS engine rather than the current working state.
+func (k Keeper) countAtVersion(version uint64) (int64, error) {
+	value, err := k.versionedStore.Get(storeKey, version, countKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read count at version %d: %w", version, err)
+	}
+	if value == nil {
+		return 0, nil
+	}
+
+	count, err := collections.Int64Value.Decode(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode count at version %d: %w", version, err)
+	}
+
+	return count, nil
+}
+
+// Subscribe registers a new WatchCount listener and returns a function to
+// unregister it. See countHub for the backpressure policy.
+func (k Keeper) Subscribe() (<-chan int64, func()) {
+	return k.hub.subscribe()
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, collections.ErrNotFound)
+}
@@ -109,9 +109,95 @@ func (m *QueryGetCountResponse) GetTotalCount() int64 {
 	return 0
 }
 
+// QueryGetCountAtRequest defines the request type for querying the count at
+// a historical version.
+type QueryGetCountAtRequest struct {
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryGetCountAtRequest) Reset()         { *m = QueryGetCountAtRequest{} }
+func (m *QueryGetCountAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCountAtRequest) ProtoMessage()    {}
+func (*QueryGetCountAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd21727562626c9f, []int{2}
+}
+func (m *QueryGetCountAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCountAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCountAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCountAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCountAtRequest.Merge(m, src)
+}
+func (m *QueryGetCountAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCountAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCountAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCountAtRequest proto.InternalMessageInfo
+
+func (m *QueryGetCountAtRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// QueryWatchCountRequest defines the request type for subscribing to count
+// changes.
+type QueryWatchCountRequest struct {
+}
+
+func (m *QueryWatchCountRequest) Reset()         { *m = QueryWatchCountRequest{} }
+func (m *QueryWatchCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchCountRequest) ProtoMessage()    {}
+func (*QueryWatchCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd21727562626c9f, []int{3}
+}
+func (m *QueryWatchCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWatchCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchCountRequest.Merge(m, src)
+}
+func (m *QueryWatchCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWatchCountRequest proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryGetCountRequest)(nil), "cosmos.counter.v1.QueryGetCountRequest")
 	proto.RegisterType((*QueryGetCountResponse)(nil), "cosmos.counter.v1.QueryGetCountResponse")
+	proto.RegisterType((*QueryGetCountAtRequest)(nil), "cosmos.counter.v1.QueryGetCountAtRequest")
+	proto.RegisterType((*QueryWatchCountRequest)(nil), "cosmos.counter.v1.QueryWatchCountRequest")
 }
 
 func init() { proto.RegisterFile("cosmos/counter/v1/query.proto", fileDescriptor_bd21727562626c9f) }
@@ -148,6 +234,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// GetCount queries the parameters of x/Counter module.
 	GetCount(ctx context.Context, in *QueryGetCountRequest, opts ...grpc.CallOption) (*QueryGetCountResponse, error)
+	// GetCountAt queries the count as it stood at a historical version.
+	GetCountAt(ctx context.Context, in *QueryGetCountAtRequest, opts ...grpc.CallOption) (*QueryGetCountResponse, error)
+	// WatchCount streams a QueryGetCountResponse every time the counter
+	// changes.
+	WatchCount(ctx context.Context, in *QueryWatchCountRequest, opts ...grpc.CallOption) (Query_WatchCountClient, error)
 }
 
 type queryClient struct {
@@ -167,10 +258,58 @@ func (c *queryClient) GetCount(ctx context.Context, in *QueryGetCountRequest, op
 	return out, nil
 }
 
+func (c *queryClient) GetCountAt(ctx context.Context, in *QueryGetCountAtRequest, opts ...grpc.CallOption) (*QueryGetCountResponse, error) {
+	out := new(QueryGetCountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.counter.v1.Query/GetCountAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WatchCount(ctx context.Context, in *QueryWatchCountRequest, opts ...grpc.CallOption) (Query_WatchCountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/cosmos.counter.v1.Query/WatchCount", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryWatchCountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// Query_WatchCountClient is the client-side stream handle returned by
+// WatchCount; callers Recv until they get io.EOF or an error.
+type Query_WatchCountClient interface {
+	Recv() (*QueryGetCountResponse, error)
+	grpc.ClientStream
+}
+
+type queryWatchCountClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryWatchCountClient) Recv() (*QueryGetCountResponse, error) {
+	m := new(QueryGetCountResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetCount queries the parameters of x/Counter module.
 	GetCount(context.Context, *QueryGetCountRequest) (*QueryGetCountResponse, error)
+	// GetCountAt queries the count as it stood at a historical version.
+	GetCountAt(context.Context, *QueryGetCountAtRequest) (*QueryGetCountResponse, error)
+	// WatchCount streams a QueryGetCountResponse every time the counter
+	// changes.
+	WatchCount(*QueryWatchCountRequest, Query_WatchCountServer) error
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -181,6 +320,14 @@ func (*UnimplementedQueryServer) GetCount(ctx context.Context, req *QueryGetCoun
 	return nil, status.Errorf(codes.Unimplemented, "method GetCount not implemented")
 }
 
+func (*UnimplementedQueryServer) GetCountAt(ctx context.Context, req *QueryGetCountAtRequest) (*QueryGetCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCountAt not implemented")
+}
+
+func (*UnimplementedQueryServer) WatchCount(req *QueryWatchCountRequest, srv Query_WatchCountServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCount not implemented")
+}
+
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
@@ -203,6 +350,48 @@ func _Query_GetCount_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetCountAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCountAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCountAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.counter.v1.Query/GetCountAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCountAt(ctx, req.(*QueryGetCountAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WatchCount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryWatchCountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).WatchCount(m, &queryWatchCountServer{stream})
+}
+
+// Query_WatchCountServer is the server-side stream handle passed to
+// WatchCount; implementations Send until the subscription ends or the
+// client disconnects.
+type Query_WatchCountServer interface {
+	Send(*QueryGetCountResponse) error
+	grpc.ServerStream
+}
+
+type queryWatchCountServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryWatchCountServer) Send(m *QueryGetCountResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.counter.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -211,8 +400,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCount",
 			Handler:    _Query_GetCount_Handler,
 		},
+		{
+			MethodName: "GetCountAt",
+			Handler:    _Query_GetCountAt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCount",
+			Handler:       _Query_WatchCount_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/counter/v1/query.proto",
 }
 
@@ -239,6 +438,54 @@ func (m *QueryGetCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetCountAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCountAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCountAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, m.Version)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetCountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -299,6 +546,27 @@ func (m *QueryGetCountResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetCountAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(m.Version)
+	}
+	return n
+}
+
+func (m *QueryWatchCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -355,6 +623,125 @@ func (m *QueryGetCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetCountAtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCountAtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCountAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGetCountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
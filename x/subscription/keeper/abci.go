@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/subscription/types"
+)
+
+// EndBlocker rolls over every subscription whose current period has elapsed,
+// resetting its remaining allowance to the plan's per-period allowance and
+// advancing its period end by one period.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	now := k.hs(ctx).Time.Unix()
+
+	var expired []string
+	err := k.Subscriptions.Walk(ctx, nil, func(account string, sub types.Subscription) (stop bool, err error) {
+		if now >= sub.PeriodEnd {
+			expired = append(expired, account)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, account := range expired {
+		sub, err := k.Subscriptions.Get(ctx, account)
+		if err != nil {
+			return err
+		}
+
+		sub.RemainingAllowance = sub.Plan.PeriodGasAllowance
+		sub.PeriodEnd += sub.Plan.PeriodSeconds
+
+		if err := k.Subscriptions.Set(ctx, account, sub); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
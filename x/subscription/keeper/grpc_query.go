@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/subscription/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Subscription returns the subscription covering an account, if any.
+func (k Keeper) Subscription(ctx context.Context, req *types.QuerySubscriptionRequest) (*types.QuerySubscriptionResponse, error) {
+	sub, err := k.Subscriptions.Get(ctx, req.Account)
+	if err != nil {
+		if isNotFound(err) {
+			return &types.QuerySubscriptionResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QuerySubscriptionResponse{Subscription: &sub}, nil
+}
+
+// SubscriptionsByAccount returns every subscription funded by or covering the
+// given address.
+func (k Keeper) SubscriptionsByAccount(ctx context.Context, req *types.QuerySubscriptionsByAccountRequest) (*types.QuerySubscriptionsByAccountResponse, error) {
+	var subs []*types.Subscription
+	err := k.Subscriptions.Walk(ctx, nil, func(_ string, sub types.Subscription) (stop bool, err error) {
+		if sub.Account == req.Address || sub.Funder == req.Address {
+			sub := sub
+			subs = append(subs, &sub)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySubscriptionsByAccountResponse{Subscriptions: subs}, nil
+}
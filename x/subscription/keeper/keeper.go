@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/subscription/types"
+)
+
+// Keeper manages subscriptions that sponsor an account's transaction fees on
+// a recurring, plan-driven basis.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeService  corestore.KVStoreService
+	headerService header.Service
+	bankKeeper    types.BankKeeper
+
+	// Subscriptions is keyed by the subscribed account's address string, since
+	// an account may have at most one active subscription at a time.
+	Subscriptions collections.Map[string, types.Subscription]
+}
+
+// NewKeeper constructs a new subscription Keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, headerService header.Service, bankKeeper types.BankKeeper) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	return Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		headerService: headerService,
+		bankKeeper:    bankKeeper,
+		Subscriptions: collections.NewMap(
+			sb, collections.NewPrefix(0), "subscriptions",
+			collections.StringKey, codec.CollValue[types.Subscription](cdc),
+		),
+	}
+}
+
+// GetSubscription returns the subscription covering account, if any.
+func (k Keeper) GetSubscription(ctx context.Context, account string) (types.Subscription, error) {
+	return k.Subscriptions.Get(ctx, account)
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, collections.ErrNotFound)
+}
+
+// ConsumeAllowance charges gasUsed against account's subscription allowance
+// if, and only if, every message in msgTypeURLs is within the plan's
+// allow-list and the allowance can cover gasUsed. On success, it moves the
+// equivalent fee amount from the funder to the fee collector module and
+// decrements the remaining allowance. Callers should fall back to the
+// account's normal fee payer whenever ok is false.
+func (k Keeper) ConsumeAllowance(ctx context.Context, account string, msgTypeURLs []string, gasUsed uint64, fee sdk.Coins, feeCollectorName string) (ok bool, err error) {
+	sub, err := k.Subscriptions.Get(ctx, account)
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if len(sub.Plan.AllowedMsgs) > 0 {
+		allowed := make(map[string]bool, len(sub.Plan.AllowedMsgs))
+		for _, typeURL := range sub.Plan.AllowedMsgs {
+			allowed[typeURL] = true
+		}
+		for _, typeURL := range msgTypeURLs {
+			if !allowed[typeURL] {
+				return false, nil
+			}
+		}
+	}
+
+	if gasUsed > sub.RemainingAllowance {
+		return false, nil
+	}
+
+	funder, err := sdk.AccAddressFromBech32(sub.Funder)
+	if err != nil {
+		return false, fmt.Errorf("invalid subscription funder address: %w", err)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, feeCollectorName, fee); err != nil {
+		return false, err
+	}
+
+	sub.RemainingAllowance -= gasUsed
+	if err := k.Subscriptions.Set(ctx, account, sub); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
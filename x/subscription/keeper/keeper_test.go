@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/subscription/types"
+)
+
+type fakeHeaderService struct{}
+
+func (fakeHeaderService) GetHeaderInfo(context.Context) header.Info {
+	return header.Info{Time: time.Unix(1000, 0)}
+}
+
+type fakeBankKeeper struct {
+	sent []sdk.Coins
+}
+
+func (k *fakeBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+	k.sent = append(k.sent, amt)
+	return nil
+}
+
+func newTestKeeper(t *testing.T, bank types.BankKeeper) Keeper {
+	t.Helper()
+	storeService := coretesting.KVStoreService(t, "subscription")
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	return NewKeeper(cdc, storeService, fakeHeaderService{}, bank)
+}
+
+func TestConsumeAllowanceChargesFunderAndDecrementsAllowance(t *testing.T) {
+	bank := &fakeBankKeeper{}
+	k := newTestKeeper(t, bank)
+	ctx := context.Background()
+
+	funder := sdk.AccAddress([]byte("funder______________")).String()
+	sub := types.Subscription{
+		Account: "account",
+		Funder:  funder,
+		Plan: &types.Plan{
+			PeriodGasAllowance: 100,
+			AllowedMsgs:        []string{"/cosmos.bank.v1beta1.MsgSend"},
+		},
+		RemainingAllowance: 100,
+	}
+	if err := k.Subscriptions.Set(ctx, "account", sub); err != nil {
+		t.Fatalf("seed subscription: %v", err)
+	}
+
+	ok, err := k.ConsumeAllowance(ctx, "account", []string{"/cosmos.bank.v1beta1.MsgSend"}, 40, sdk.NewCoins(), "fee_collector")
+	if err != nil {
+		t.Fatalf("ConsumeAllowance returned error: %v", err)
+	}
+	if !ok {
+		t.Fatalf("expected ConsumeAllowance to succeed")
+	}
+	if len(bank.sent) != 1 {
+		t.Fatalf("expected exactly one fee transfer to the fee collector, got %d", len(bank.sent))
+	}
+
+	got, err := k.Subscriptions.Get(ctx, "account")
+	if err != nil {
+		t.Fatalf("get subscription: %v", err)
+	}
+	if got.RemainingAllowance != 60 {
+		t.Fatalf("expected remaining allowance 60, got %d", got.RemainingAllowance)
+	}
+}
+
+func TestConsumeAllowanceRejectsDisallowedMsgWithoutCharging(t *testing.T) {
+	bank := &fakeBankKeeper{}
+	k := newTestKeeper(t, bank)
+	ctx := context.Background()
+
+	funder := sdk.AccAddress([]byte("funder______________")).String()
+	sub := types.Subscription{
+		Account:            "account",
+		Funder:             funder,
+		Plan:               &types.Plan{PeriodGasAllowance: 100, AllowedMsgs: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		RemainingAllowance: 100,
+	}
+	if err := k.Subscriptions.Set(ctx, "account", sub); err != nil {
+		t.Fatalf("seed subscription: %v", err)
+	}
+
+	ok, err := k.ConsumeAllowance(ctx, "account", []string{"/cosmos.staking.v1beta1.MsgDelegate"}, 10, sdk.NewCoins(), "fee_collector")
+	if err != nil {
+		t.Fatalf("ConsumeAllowance returned error: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected ConsumeAllowance to report ok=false for a disallowed message")
+	}
+	if len(bank.sent) != 0 {
+		t.Fatalf("expected no fee transfer when ConsumeAllowance reports ok=false, got %d", len(bank.sent))
+	}
+}
+
+func TestConsumeAllowanceRejectsWhenGasExceedsRemaining(t *testing.T) {
+	bank := &fakeBankKeeper{}
+	k := newTestKeeper(t, bank)
+	ctx := context.Background()
+
+	funder := sdk.AccAddress([]byte("funder______________")).String()
+	sub := types.Subscription{
+		Account:            "account",
+		Funder:             funder,
+		Plan:               &types.Plan{PeriodGasAllowance: 100},
+		RemainingAllowance: 5,
+	}
+	if err := k.Subscriptions.Set(ctx, "account", sub); err != nil {
+		t.Fatalf("seed subscription: %v", err)
+	}
+
+	ok, err := k.ConsumeAllowance(ctx, "account", nil, 10, sdk.NewCoins(), "fee_collector")
+	if err != nil {
+		t.Fatalf("ConsumeAllowance returned error: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected ConsumeAllowance to report ok=false when gasUsed exceeds the remaining allowance")
+	}
+	if len(bank.sent) != 0 {
+		t.Fatalf("expected no fee transfer when ConsumeAllowance reports ok=false, got %d", len(bank.sent))
+	}
+}
+
+func TestConsumeAllowanceNoSubscriptionIsANoOp(t *testing.T) {
+	bank := &fakeBankKeeper{}
+	k := newTestKeeper(t, bank)
+	ctx := context.Background()
+
+	ok, err := k.ConsumeAllowance(ctx, "no-such-account", nil, 1, sdk.NewCoins(), "fee_collector")
+	if err != nil {
+		t.Fatalf("ConsumeAllowance returned error: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected ConsumeAllowance to report ok=false for an account with no subscription")
+	}
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/header"
+	"github.com/cosmos/cosmos-sdk/x/subscription/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// CreateSubscription opens a new subscription funding account's fees
+// according to plan. A subscribed account may only have one active
+// subscription; callers must cancel the existing one first.
+func (k Keeper) CreateSubscription(ctx context.Context, msg *types.MsgCreateSubscription) (*types.MsgCreateSubscriptionResponse, error) {
+	if _, err := k.Subscriptions.Get(ctx, msg.Account); err == nil {
+		return nil, fmt.Errorf("account %s already has an active subscription", msg.Account)
+	} else if !isNotFound(err) {
+		return nil, err
+	}
+
+	periodEnd := k.hs(ctx).Time.Unix() + msg.Plan.PeriodSeconds
+
+	sub := types.Subscription{
+		Account:            msg.Account,
+		Funder:             msg.Funder,
+		Plan:               msg.Plan,
+		RemainingAllowance: msg.Plan.PeriodGasAllowance,
+		PeriodEnd:          periodEnd,
+	}
+	if err := k.Subscriptions.Set(ctx, msg.Account, sub); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSubscriptionResponse{}, nil
+}
+
+// CancelSubscription ends an existing subscription. Either the funder or the
+// subscribed account may cancel it.
+func (k Keeper) CancelSubscription(ctx context.Context, msg *types.MsgCancelSubscription) (*types.MsgCancelSubscriptionResponse, error) {
+	sub, err := k.Subscriptions.Get(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Signer != sub.Funder && msg.Signer != sub.Account {
+		return nil, fmt.Errorf("unauthorized: only the funder or the subscribed account may cancel this subscription")
+	}
+
+	if err := k.Subscriptions.Remove(ctx, msg.Account); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelSubscriptionResponse{}, nil
+}
+
+// TopUpSubscription adds gas allowance to the current period without waiting
+// for the next roll-over. Only the funder may top up.
+func (k Keeper) TopUpSubscription(ctx context.Context, msg *types.MsgTopUpSubscription) (*types.MsgTopUpSubscriptionResponse, error) {
+	sub, err := k.Subscriptions.Get(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Funder != sub.Funder {
+		return nil, fmt.Errorf("unauthorized: only the funder may top up this subscription")
+	}
+
+	sub.RemainingAllowance += msg.GasAllowance
+	if err := k.Subscriptions.Set(ctx, msg.Account, sub); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTopUpSubscriptionResponse{}, nil
+}
+
+// hs returns the header service's header info for the current block, used to
+// compute a subscription's initial period end.
+func (k Keeper) hs(ctx context.Context) header.Info {
+	return k.headerService.GetHeaderInfo(ctx)
+}